@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// bitbucketRepository is the wire shape returned by the Bitbucket Cloud
+// API, which uses different field names than GitHub for the same concepts
+// (is_private vs private, no auto_init support, etc).
+type bitbucketRepository struct {
+	UUID string `json:"uuid"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+	FullName string `json:"full_name"`
+	Description string `json:"description"`
+	IsPrivate bool `json:"is_private"`
+	HasWiki bool `json:"has_wiki"`
+	HasIssues bool `json:"has_issues"`
+	Owner struct {
+		Username string `json:"username"`
+	} `json:"owner"`
+}
+
+// toRepository normalizes a Bitbucket repository into the provider's
+// backend-agnostic Repository model so RepositoryResource doesn't need to
+// know which backend produced it.
+func (b bitbucketRepository) toRepository() *Repository {
+	repo := &Repository{
+		ID: bitbucketRepoID(b.UUID, b.FullName),
+		Name: b.Name,
+		FullName: b.FullName,
+		Description: b.Description,
+		Private: b.IsPrivate,
+		HasIssues: b.HasIssues,
+		HasWiki: b.HasWiki,
+	}
+	repo.Owner.Login = b.Owner.Username
+	return repo
+}
+
+// bitbucketRepoID derives a stable int64 identifier for a Bitbucket
+// repository. Repository.ID is an int64 because that's what GitHub's API
+// returns, but Bitbucket keys repositories by UUID, not an integer, so we
+// hash the UUID (falling back to full_name if a response ever omits it)
+// instead of leaving every Bitbucket-backed resource with the same id = 0.
+func bitbucketRepoID(uuid, fullName string) int64 {
+	key := uuid
+	if key == "" {
+		key = fullName
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return int64(h.Sum64())
+}
+
+// bitbucketRepositoryRequest is the wire shape Bitbucket expects on
+// create/update.
+type bitbucketRepositoryRequest struct {
+	Description string `json:"description,omitempty"`
+	IsPrivate bool `json:"is_private"`
+	HasIssues bool `json:"has_issues"`
+	HasWiki bool `json:"has_wiki"`
+}
+
+// BitbucketAPIError is returned by BitbucketClient for any non-2xx
+// response, mirroring Bitbucket's {"error":{"message":...}} envelope.
+type BitbucketAPIError struct {
+	StatusCode int
+	Endpoint string
+	Method string
+	Message string
+}
+
+func (e *BitbucketAPIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s %s: HTTP %d: %s", e.Method, e.Endpoint, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s %s: HTTP %d", e.Method, e.Endpoint, e.StatusCode)
+}
+
+func (e *BitbucketAPIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// BitbucketClient implements RepoProvider against the Bitbucket Cloud API
+// using HTTP Basic auth (username + app password), as documented for the
+// external Bitbucket provider.
+type BitbucketClient struct {
+	httpClient *http.Client
+	username string
+	appPassword string
+	baseUrl string
+}
+
+func NewBitbucketClient(username, appPassword string) *BitbucketClient {
+	return &BitbucketClient{
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		username: username,
+		appPassword: appPassword,
+		baseUrl: "https://api.bitbucket.org/2.0",
+	}
+}
+
+func (c *BitbucketClient) genericRequest(ctx context.Context, httpMethod, path string, body interface{}) (*http.Response, error) {
+	var reqBody bytes.Buffer
+
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return nil, fmt.Errorf("failed to encode req body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpMethod, c.baseUrl+path, &reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.SetBasicAuth(c.username, c.appPassword)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	apiErr := &BitbucketAPIError{StatusCode: resp.StatusCode, Endpoint: path, Method: httpMethod}
+
+	var envelope struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.NewDecoder(resp.Body).Decode(&envelope) == nil {
+		apiErr.Message = envelope.Error.Message
+	}
+
+	return nil, apiErr
+}
+
+// scopedByOwner marks BitbucketClient as satisfying OwnerScopedRepoProvider.
+func (c *BitbucketClient) scopedByOwner() {}
+
+func (c *BitbucketClient) CreateRepo(ctx context.Context, req *CreateRepositoryRequest) (*Repository, error) {
+	if req.Owner == "" {
+		return nil, fmt.Errorf("owner (workspace) is required to create a Bitbucket repository")
+	}
+
+	path := fmt.Sprintf("/repositories/%s/%s", req.Owner, repoSlug(req.Name))
+	resp, err := c.genericRequest(ctx, "POST", path, &bitbucketRepositoryRequest{
+		Description: req.Description,
+		IsPrivate: req.Private,
+		HasIssues: req.HasIssues,
+		HasWiki: req.HasWiki,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var bbRepo bitbucketRepository
+	if err := json.NewDecoder(resp.Body).Decode(&bbRepo); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return bbRepo.toRepository(), nil
+}
+
+func (c *BitbucketClient) GetRepo(ctx context.Context, owner, name string) (*Repository, error) {
+	path := fmt.Sprintf("/repositories/%s/%s", owner, repoSlug(name))
+	resp, err := c.genericRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var bbRepo bitbucketRepository
+	if err := json.NewDecoder(resp.Body).Decode(&bbRepo); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return bbRepo.toRepository(), nil
+}
+
+func (c *BitbucketClient) UpdateRepo(ctx context.Context, owner, name string, req *UpdateRepositoryRequest) (*Repository, error) {
+	path := fmt.Sprintf("/repositories/%s/%s", owner, repoSlug(name))
+	resp, err := c.genericRequest(ctx, "PUT", path, &bitbucketRepositoryRequest{
+		Description: req.Description,
+		IsPrivate: req.Private,
+		HasIssues: req.HasIssues,
+		HasWiki: req.HasWiki,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var bbRepo bitbucketRepository
+	if err := json.NewDecoder(resp.Body).Decode(&bbRepo); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return bbRepo.toRepository(), nil
+}
+
+func (c *BitbucketClient) DeleteRepo(ctx context.Context, owner, name string) error {
+	path := fmt.Sprintf("/repositories/%s/%s", owner, repoSlug(name))
+
+	resp, err := c.genericRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// repoSlug lowercases a repository name into the slug form Bitbucket
+// requires in its URLs.
+func repoSlug(name string) string {
+	return strings.ToLower(name)
+}
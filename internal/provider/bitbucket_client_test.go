@@ -0,0 +1,49 @@
+package provider
+
+import "testing"
+
+func TestBitbucketRepoID(t *testing.T) {
+	a := bitbucketRepoID("{11111111-1111-1111-1111-111111111111}", "acme/repo-one")
+	b := bitbucketRepoID("{22222222-2222-2222-2222-222222222222}", "acme/repo-two")
+
+	if a == 0 || b == 0 {
+		t.Fatalf("expected non-zero ids, got a=%d b=%d", a, b)
+	}
+	if a == b {
+		t.Fatalf("expected distinct repos to hash to distinct ids, both got %d", a)
+	}
+	if got := bitbucketRepoID("{11111111-1111-1111-1111-111111111111}", "acme/repo-one"); got != a {
+		t.Fatalf("expected id to be stable across calls, got %d want %d", got, a)
+	}
+	if got := bitbucketRepoID("", "acme/repo-one"); got != bitbucketRepoID("", "acme/repo-one") {
+		t.Fatalf("expected fallback to full_name to be stable when uuid is empty")
+	}
+}
+
+func TestBitbucketRepositoryToRepository(t *testing.T) {
+	bb := bitbucketRepository{
+		UUID: "{11111111-1111-1111-1111-111111111111}",
+		Name: "repo-one",
+		FullName: "acme/repo-one",
+		Description: "an example repo",
+		IsPrivate: true,
+		HasWiki: true,
+		HasIssues: false,
+	}
+	bb.Owner.Username = "acme"
+
+	repo := bb.toRepository()
+
+	if repo.ID == 0 {
+		t.Error("expected ID to be populated from the Bitbucket UUID")
+	}
+	if repo.Name != bb.Name || repo.FullName != bb.FullName || repo.Description != bb.Description {
+		t.Errorf("name/full_name/description not copied through: %+v", repo)
+	}
+	if repo.Private != bb.IsPrivate || repo.HasWiki != bb.HasWiki || repo.HasIssues != bb.HasIssues {
+		t.Errorf("private/has_wiki/has_issues not copied through: %+v", repo)
+	}
+	if repo.Owner.Login != bb.Owner.Username {
+		t.Errorf("owner.login = %q, want %q", repo.Owner.Login, bb.Owner.Username)
+	}
+}
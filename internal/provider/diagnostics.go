@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// addAPIErrorDiagnostics unwraps a GitHubAPIError and emits one diagnostic
+// per field-scoped validation error GitHub returned, falling back to a
+// single summary-level error for anything else (auth failures, 5xx, etc).
+func addAPIErrorDiagnostics(diags *diag.Diagnostics, summary string, err error) {
+	var apiErr *GitHubAPIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	for _, fieldErr := range apiErr.Errors {
+		if fieldErr.Field == "" {
+			diags.AddError(summary, fieldErr.Message)
+			continue
+		}
+		diags.AddAttributeError(path.Root(fieldErr.Field), summary, fieldErr.Message)
+	}
+}
+
+// notFoundAPIError is implemented by both GitHubAPIError and
+// BitbucketAPIError so isNotFoundError works the same way regardless of
+// which backend a RepoProvider is talking to.
+type notFoundAPIError interface {
+	error
+	IsNotFound() bool
+}
+
+// isNotFoundError reports whether err is a backend API error for a 404
+// response.
+func isNotFoundError(err error) bool {
+	var apiErr notFoundAPIError
+	return errors.As(err, &apiErr) && apiErr.IsNotFound()
+}
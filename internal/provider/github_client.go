@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
 	"time"
 	"fmt"
 	"net/http"
@@ -19,6 +22,15 @@ type Repository struct {
 	HasIssues bool `json:"has_issues"`
 	HasWiki bool `json:"has_wiki"`
 	AutoInit bool `json:"auto_init,omitempty"`
+	IsTemplate bool `json:"is_template"`
+	Homepage string `json:"homepage"`
+	HasDownloads bool `json:"has_downloads"`
+	DefaultBranch string `json:"default_branch"`
+	SSHCloneURL string `json:"ssh_url"`
+	GitCloneURL string `json:"git_url"`
+	HTTPCloneURL string `json:"clone_url"`
+	SvnURL string `json:"svn_url"`
+	Topics []string `json:"topics"`
 	Owner struct {
 		Login string `json:"login"`
 	} `json:"owner"`
@@ -26,12 +38,25 @@ type Repository struct {
 
 // create repo request
 type CreateRepositoryRequest struct {
+	// Owner is the target workspace/organization for backends (e.g.
+	// Bitbucket) whose create endpoint is scoped by owner in the URL
+	// rather than implied by the authenticated user. GitHubClient ignores
+	// it, since POST /user/repos always creates under the calling user.
+	Owner string `json:"-"`
 	Name string `json:"name"`
 	Description string `json:"description"`
 	Private bool `json:"private"`
 	HasIssues bool `json:"has_issues"`
 	HasWiki bool `json:"has_wiki"`
 	AutoInit bool `json:"auto_init,omitempty"`
+	Homepage string `json:"homepage,omitempty"`
+	// HasDownloads must always be sent, even when false: the schema
+	// default is true, so omitempty would silently drop an explicit
+	// "false" and let GitHub create the repo with downloads enabled,
+	// producing a permanent diff against config (see UpdateRepositoryRequest).
+	HasDownloads bool `json:"has_downloads"`
+	LicenseTemplate string `json:"license_template,omitempty"`
+	GitignoreTemplate string `json:"gitignore_template,omitempty"`
 }
 
 // update repo request
@@ -41,6 +66,92 @@ type UpdateRepositoryRequest struct {
 	Private bool `json:"private"`
 	HasIssues bool `json:"has_issues"`
 	HasWiki bool `json:"has_wiki"`
+	IsTemplate bool `json:"is_template"`
+	Homepage string `json:"homepage,omitempty"`
+	HasDownloads bool `json:"has_downloads"`
+}
+
+// request body for PUT /repos/{owner}/{repo}/topics
+type TopicsRequest struct {
+	Names []string `json:"names"`
+}
+
+// response body for PUT /repos/{owner}/{repo}/topics
+type topicsResponse struct {
+	Names []string `json:"names"`
+}
+
+// request body for POST /repos/{template_owner}/{template_repo}/generate
+type GenerateRepositoryRequest struct {
+	Owner string `json:"owner,omitempty"`
+	Name string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private bool `json:"private,omitempty"`
+	IncludeAllBranches bool `json:"include_all_branches,omitempty"`
+}
+
+// request body for POST /repos/{owner}/{repo}/forks
+type ForkRepositoryRequest struct {
+	Organization string `json:"organization,omitempty"`
+	Name string `json:"name,omitempty"`
+	DefaultBranchOnly bool `json:"default_branch_only,omitempty"`
+}
+
+// github repo webhook
+type Hook struct {
+	ID	int64 `json:"id"`
+	Active bool `json:"active"`
+	Events []string `json:"events"`
+	Config HookConfig `json:"config"`
+}
+
+type HookConfig struct {
+	URL string `json:"url"`
+	ContentType string `json:"content_type,omitempty"`
+	InsecureSSL string `json:"insecure_ssl,omitempty"`
+	Secret string `json:"secret,omitempty"`
+}
+
+// create/update hook request, GitHub requires "name":"web" for repo hooks
+type HookRequest struct {
+	Name string `json:"name"`
+	Active bool `json:"active"`
+	Events []string `json:"events"`
+	Config HookConfig `json:"config"`
+}
+
+// FieldError mirrors one entry of GitHub's `errors[]` validation array,
+// e.g. {"resource":"Repository","field":"name","code":"custom","message":"name already exists"}
+type FieldError struct {
+	Resource string `json:"resource"`
+	Field string `json:"field"`
+	Code string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GitHubAPIError is returned by genericRequest for any non-2xx response,
+// carrying enough detail for callers to emit precise diagnostics instead
+// of a bare HTTP status code.
+type GitHubAPIError struct {
+	StatusCode int
+	Endpoint string
+	Method string
+	Message string
+	DocumentationURL string
+	Errors []FieldError
+	RateLimitRemaining int
+	RateLimitReset time.Time
+}
+
+func (e *GitHubAPIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s %s: HTTP %d: %s", e.Method, e.Endpoint, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s %s: HTTP %d", e.Method, e.Endpoint, e.StatusCode)
+}
+
+func (e *GitHubAPIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
 }
 
 // api client
@@ -50,18 +161,100 @@ type GitHubClient struct {
 	baseUrl string
 }
 
+// clientConfig holds the defaults applied by NewGithubClient before
+// ClientOptions are layered on top.
+type clientConfig struct {
+	maxRetries int
+	minRateLimitRemaining int
+	maxConcurrentRequests int
+	clock func() time.Time
+	baseURL string
+}
+
+// WithBaseURL points the client at a GitHub Enterprise Server instance
+// (typically "https://HOSTNAME/api/v3") instead of github.com.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.baseURL = baseURL
+	}
+}
+
+// ClientOption customizes a GitHubClient constructed via NewGithubClient.
+type ClientOption func(*clientConfig)
+
+// WithMaxRetries caps how many times a request is retried after hitting a
+// primary or secondary rate limit before giving up. Default 3.
+func WithMaxRetries(maxRetries int) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.maxRetries = maxRetries
+	}
+}
+
+// WithMinRateLimitRemaining sets the X-RateLimit-Remaining threshold at or
+// below which requests block until the rate limit window resets. Default 1.
+func WithMinRateLimitRemaining(minRemaining int) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.minRateLimitRemaining = minRemaining
+	}
+}
+
+// WithMaxConcurrentRequests caps the number of requests the client will
+// have in flight at once, to avoid tripping GitHub's abuse detection during
+// bulk applies. Default 10.
+func WithMaxConcurrentRequests(maxConcurrent int) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.maxConcurrentRequests = maxConcurrent
+	}
+}
+
+// WithClock overrides the clock the rate limit transport uses to decide how
+// long to sleep, primarily so tests can use a fake clock.
+func WithClock(clock func() time.Time) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.clock = clock
+	}
+}
+
 // for creating new Github client
-func NewGithubClient(token string) *GitHubClient {
+func NewGithubClient(token string, opts ...ClientOption) *GitHubClient {
+	cfg := &clientConfig{
+		maxRetries: 3,
+		minRateLimitRemaining: 1,
+		maxConcurrentRequests: 10,
+		clock: time.Now,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	baseUrl := "https://api.github.com"
+	if cfg.baseURL != "" {
+		baseUrl = cfg.baseURL
+	}
+
 	return &GitHubClient{
+		// No Client.Timeout: the rate limit transport can legitimately
+		// block inside RoundTrip for up to an hour waiting for the
+		// primary rate limit window to reset, so a fixed client-wide
+		// timeout would kill that wait long before it completes.
+		// Callers that want a deadline should set one on the context
+		// they pass in.
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Transport: newRateLimitTransport(http.DefaultTransport, cfg),
 		},
 		token: token,
-		baseUrl: "https://api.github.com",
+		baseUrl: baseUrl,
 	}
 }
 
 func (c *GitHubClient) genericRequest(ctx context.Context, httpMethod, path string, body interface{}) (*http.Response, error) {
+	return c.genericRequestWithAccept(ctx, httpMethod, path, body, "application/vnd.github.v3+json")
+}
+
+// genericRequestWithAccept is genericRequest with an overridable Accept
+// header, for endpoints that are still gated behind a preview media type
+// (e.g. the repository topics endpoint).
+func (c *GitHubClient) genericRequestWithAccept(ctx context.Context, httpMethod, path string, body interface{}, accept string) (*http.Response, error) {
 	var reqBody bytes.Buffer
 
 	if body != nil {
@@ -78,7 +271,7 @@ func (c *GitHubClient) genericRequest(ctx context.Context, httpMethod, path stri
 	}
 
 	req.Header.Set("Authorization", "token "+c.token)
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("Accept", accept)
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -88,7 +281,50 @@ func (c *GitHubClient) genericRequest(ctx context.Context, httpMethod, path stri
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 
-	return resp, nil
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	return nil, c.parseAPIError(resp, httpMethod, path)
+}
+
+// parseAPIError reads a non-2xx response body and turns it into a
+// GitHubAPIError, unmarshalling GitHub's {message, documentation_url,
+// errors[]} envelope and capturing the rate limit headers.
+func (c *GitHubClient) parseAPIError(resp *http.Response, httpMethod, path string) error {
+	apiErr := &GitHubAPIError{
+		StatusCode: resp.StatusCode,
+		Endpoint: path,
+		Method: httpMethod,
+	}
+
+	if remaining, convErr := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); convErr == nil {
+		apiErr.RateLimitRemaining = remaining
+	}
+	if reset, convErr := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); convErr == nil {
+		apiErr.RateLimitReset = time.Unix(reset, 0)
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil || len(bodyBytes) == 0 {
+		return apiErr
+	}
+
+	var envelope struct {
+		Message string `json:"message"`
+		DocumentationURL string `json:"documentation_url"`
+		Errors []FieldError `json:"errors"`
+	}
+	if jsonErr := json.Unmarshal(bodyBytes, &envelope); jsonErr != nil {
+		return apiErr
+	}
+
+	apiErr.Message = envelope.Message
+	apiErr.DocumentationURL = envelope.DocumentationURL
+	apiErr.Errors = envelope.Errors
+
+	return apiErr
 }
 
 
@@ -102,14 +338,6 @@ func (c *GitHubClient) GetRepo(ctx context.Context, owner, name string) (*Reposi
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("repository not found")
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get repo: HTTP %d", resp.StatusCode)
-	}
-
 	var repo Repository
 	e := json.NewDecoder(resp.Body).Decode(&repo)
 	if e != nil {
@@ -126,10 +354,6 @@ func (c *GitHubClient) CreateRepo(ctx context.Context, req *CreateRepositoryRequ
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated{
-		return nil, fmt.Errorf("repository not created: HTTP %d", resp.StatusCode)
-	}
-
 	var repo Repository
 	e := json.NewDecoder(resp.Body).Decode(&repo)
 	if e != nil {
@@ -141,42 +365,177 @@ func (c *GitHubClient) CreateRepo(ctx context.Context, req *CreateRepositoryRequ
 
 func (c *GitHubClient) UpdateRepo(ctx context.Context, owner, name string, req *UpdateRepositoryRequest) (*Repository, error) {
 	path:= fmt.Sprintf("/repos/%s/%s", owner, name)
-	resp, err := c.genericRequest(ctx, "PATCH", c.baseUrl+path, req)
-
+	resp, err := c.genericRequest(ctx, "PATCH", path, req)
 	if err!=nil {
 		return nil, err
 	}
 
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to update repo: HTTP %d", resp.StatusCode)
+	var repo Repository
+	e := json.NewDecoder(resp.Body).Decode(&repo)
+	if e!=nil {
+		return nil, fmt.Errorf("failed to decode response: %w", e)
 	}
 
+	return &repo, nil
+}
+
+func (c *GitHubClient) GenerateRepoFromTemplate(ctx context.Context, templateOwner, templateRepo string, req *GenerateRepositoryRequest) (*Repository, error) {
+	path := fmt.Sprintf("/repos/%s/%s/generate", templateOwner, templateRepo)
+	resp, err := c.genericRequest(ctx, "POST", path, req)
+	if err!=nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
 	var repo Repository
 	e := json.NewDecoder(resp.Body).Decode(&repo)
-	if e!=nil {
+	if e != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", e)
+	}
+
+	return &repo, nil
+}
+
+func (c *GitHubClient) ForkRepo(ctx context.Context, owner, name string, req *ForkRepositoryRequest) (*Repository, error) {
+	path := fmt.Sprintf("/repos/%s/%s/forks", owner, name)
+	resp, err := c.genericRequest(ctx, "POST", path, req)
+	if err!=nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var repo Repository
+	e := json.NewDecoder(resp.Body).Decode(&repo)
+	if e != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", e)
 	}
 
 	return &repo, nil
 }
 
+// WaitForForkReady polls GetRepo until the asynchronously created fork
+// becomes available, since GitHub returns 202 Accepted from ForkRepo
+// before the fork has actually finished being created.
+func (c *GitHubClient) WaitForForkReady(ctx context.Context, owner, name string) (*Repository, error) {
+	const (
+		pollInterval = 2 * time.Second
+		maxAttempts  = 30
+	)
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		repo, err := c.GetRepo(ctx, owner, name)
+		if err == nil {
+			return repo, nil
+		}
+
+		var apiErr *GitHubAPIError
+		if !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+
+	return nil, fmt.Errorf("timed out waiting for forked repository %s/%s to become ready", owner, name)
+}
+
+// SetTopics replaces a repository's topics, requiring GitHub's mercy-preview
+// media type since the endpoint predates topics becoming generally
+// available.
+func (c *GitHubClient) SetTopics(ctx context.Context, owner, name string, topics []string) ([]string, error) {
+	path := fmt.Sprintf("/repos/%s/%s/topics", owner, name)
+	resp, err := c.genericRequestWithAccept(ctx, "PUT", path, &TopicsRequest{Names: topics}, "application/vnd.github.mercy-preview+json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result topicsResponse
+	if e := json.NewDecoder(resp.Body).Decode(&result); e != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", e)
+	}
+
+	return result.Names, nil
+}
+
 func (c *GitHubClient) DeleteRepo(ctx context.Context, owner, name string) error {
 	path := fmt.Sprintf("/repos/%s/%s", owner, name)
 
 	resp, err := c.genericRequest(ctx, "DELETE", path, nil)
-
 	if err !=nil {
 		return err
 	}
+	defer resp.Body.Close()
+
+	return nil
+}
 
+func (c *GitHubClient) CreateHook(ctx context.Context, owner, repo string, req *HookRequest) (*Hook, error) {
+	path := fmt.Sprintf("/repos/%s/%s/hooks", owner, repo)
+	resp, err := c.genericRequest(ctx, "POST", path, req)
+	if err!=nil {
+		return nil, err
+	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("failed to delete repo: HTTP %d", resp.StatusCode)
+	var hook Hook
+	e := json.NewDecoder(resp.Body).Decode(&hook)
+	if e != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", e)
+	}
+
+	return &hook, nil
+}
+
+func (c *GitHubClient) GetHook(ctx context.Context, owner, repo string, id int64) (*Hook, error) {
+	path := fmt.Sprintf("/repos/%s/%s/hooks/%d", owner, repo, id)
+	resp, err := c.genericRequest(ctx, "GET", path, nil)
+	if err!=nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var hook Hook
+	e := json.NewDecoder(resp.Body).Decode(&hook)
+	if e != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", e)
 	}
 
+	return &hook, nil
+}
+
+func (c *GitHubClient) UpdateHook(ctx context.Context, owner, repo string, id int64, req *HookRequest) (*Hook, error) {
+	path := fmt.Sprintf("/repos/%s/%s/hooks/%d", owner, repo, id)
+	resp, err := c.genericRequest(ctx, "PATCH", path, req)
+	if err!=nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var hook Hook
+	e := json.NewDecoder(resp.Body).Decode(&hook)
+	if e != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", e)
+	}
+
+	return &hook, nil
+}
+
+func (c *GitHubClient) DeleteHook(ctx context.Context, owner, repo string, id int64) error {
+	path := fmt.Sprintf("/repos/%s/%s/hooks/%d", owner, repo, id)
+
+	resp, err := c.genericRequest(ctx, "DELETE", path, nil)
+	if err !=nil {
+		return err
+	}
+	defer resp.Body.Close()
+
 	return nil
 }
 
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newAPIErrorResponse(statusCode int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func TestParseAPIError(t *testing.T) {
+	client := &GitHubClient{}
+
+	header := make(http.Header)
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "1700000000")
+
+	body := `{"message":"name already exists","documentation_url":"https://docs.github.com/rest","errors":[{"resource":"Repository","field":"name","code":"custom","message":"name already exists"}]}`
+	resp := newAPIErrorResponse(http.StatusUnprocessableEntity, header, body)
+
+	err := client.parseAPIError(resp, "POST", "/user/repos")
+
+	apiErr, ok := err.(*GitHubAPIError)
+	if !ok {
+		t.Fatalf("expected *GitHubAPIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusUnprocessableEntity)
+	}
+	if apiErr.Method != "POST" || apiErr.Endpoint != "/user/repos" {
+		t.Errorf("Method/Endpoint = %q %q, want POST /user/repos", apiErr.Method, apiErr.Endpoint)
+	}
+	if apiErr.Message != "name already exists" {
+		t.Errorf("Message = %q, want %q", apiErr.Message, "name already exists")
+	}
+	if apiErr.DocumentationURL != "https://docs.github.com/rest" {
+		t.Errorf("DocumentationURL = %q", apiErr.DocumentationURL)
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Field != "name" {
+		t.Errorf("Errors = %+v, want one FieldError for field \"name\"", apiErr.Errors)
+	}
+	if apiErr.RateLimitRemaining != 0 {
+		t.Errorf("RateLimitRemaining = %d, want 0", apiErr.RateLimitRemaining)
+	}
+	if !apiErr.RateLimitReset.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("RateLimitReset = %v, want %v", apiErr.RateLimitReset, time.Unix(1700000000, 0))
+	}
+}
+
+func TestParseAPIError_nonJSONBody(t *testing.T) {
+	client := &GitHubClient{}
+
+	resp := newAPIErrorResponse(http.StatusBadGateway, nil, "<html>not json</html>")
+	err := client.parseAPIError(resp, "GET", "/repos/acme/widget")
+
+	apiErr, ok := err.(*GitHubAPIError)
+	if !ok {
+		t.Fatalf("expected *GitHubAPIError, got %T", err)
+	}
+	if apiErr.Message != "" {
+		t.Errorf("Message = %q, want empty for a non-JSON body", apiErr.Message)
+	}
+}
+
+func TestParseAPIError_emptyBody(t *testing.T) {
+	client := &GitHubClient{}
+
+	resp := newAPIErrorResponse(http.StatusNotFound, nil, "")
+	err := client.parseAPIError(resp, "GET", "/repos/acme/widget")
+
+	apiErr, ok := err.(*GitHubAPIError)
+	if !ok {
+		t.Fatalf("expected *GitHubAPIError, got %T", err)
+	}
+	if !apiErr.IsNotFound() {
+		t.Errorf("expected IsNotFound() for a 404 response")
+	}
+	if apiErr.Message != "" {
+		t.Errorf("Message = %q, want empty for an empty body", apiErr.Message)
+	}
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// provider defined types satisfy framework
+
+var _ provider.Provider = &GithubProvider{}
+var _ provider.ProviderWithValidateConfig = &GithubProvider{}
+
+// backendGitHub and backendBitbucket are the values accepted by the
+// "backend" provider attribute.
+const (
+	backendGitHub    = "github"
+	backendBitbucket = "bitbucket"
+)
+
+type GithubProvider struct {
+	// version is set by the release process and reported to Terraform
+	// via Metadata; it has no effect on provider behavior.
+	version string
+}
+
+// GithubProviderModel is the data model for the provider's own
+// configuration block.
+type GithubProviderModel struct {
+	Backend     types.String `tfsdk:"backend"`
+	BaseURL     types.String `tfsdk:"base_url"`
+	Token       types.String `tfsdk:"token"`
+	Username    types.String `tfsdk:"username"`
+	AppPassword types.String `tfsdk:"app_password"`
+}
+
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &GithubProvider{version: version}
+	}
+}
+
+func (p *GithubProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "github"
+	resp.Version = p.version
+}
+
+func (p *GithubProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages repositories against GitHub, GitHub Enterprise Server, or Bitbucket Cloud, depending on `backend`.",
+
+		Attributes: map[string]schema.Attribute{
+			"backend": schema.StringAttribute{
+				MarkdownDescription: "Which backend to talk to: `github` (default) or `bitbucket`.",
+				Optional:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL for a GitHub Enterprise Server instance, e.g. `https://HOSTNAME/api/v3`. Only applies when `backend` is `github`.",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "GitHub personal access token. Only applies when `backend` is `github`. Defaults to the `GITHUB_TOKEN` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Bitbucket username. Only applies when `backend` is `bitbucket`.",
+				Optional:            true,
+			},
+			"app_password": schema.StringAttribute{
+				MarkdownDescription: "Bitbucket app password. Only applies when `backend` is `bitbucket`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// ValidateConfig warns when a practitioner sets an attribute that the
+// selected backend ignores, e.g. a GitHub token alongside backend =
+// "bitbucket", so a typo in "backend" doesn't fail silently.
+func (p *GithubProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data GithubProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend := data.Backend.ValueString()
+	if backend == "" {
+		backend = backendGitHub
+	}
+
+	switch backend {
+	case backendGitHub:
+		if !data.Username.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(path.Root("username"), "Unused attribute", "username is ignored when backend is \"github\"; did you mean to set \"token\" instead?")
+		}
+		if !data.AppPassword.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(path.Root("app_password"), "Unused attribute", "app_password is ignored when backend is \"github\"; did you mean to set \"token\" instead?")
+		}
+	case backendBitbucket:
+		if !data.Token.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(path.Root("token"), "Unused attribute", "token is ignored when backend is \"bitbucket\"; did you mean to set \"app_password\" instead?")
+		}
+		if !data.BaseURL.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(path.Root("base_url"), "Unused attribute", "base_url is ignored when backend is \"bitbucket\"; it only applies to GitHub Enterprise Server.")
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("backend"), "Unsupported backend", fmt.Sprintf("backend must be %q or %q, got %q", backendGitHub, backendBitbucket, backend))
+	}
+}
+
+func (p *GithubProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data GithubProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend := data.Backend.ValueString()
+	if backend == "" {
+		backend = backendGitHub
+	}
+
+	var client RepoProvider
+	switch backend {
+	case backendGitHub:
+		token := data.Token.ValueString()
+		if token == "" {
+			token = os.Getenv("GITHUB_TOKEN")
+		}
+
+		var opts []ClientOption
+		if baseURL := data.BaseURL.ValueString(); baseURL != "" {
+			opts = append(opts, WithBaseURL(baseURL))
+		}
+		client = NewGithubClient(token, opts...)
+	case backendBitbucket:
+		client = NewBitbucketClient(data.Username.ValueString(), data.AppPassword.ValueString())
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("backend"), "Unsupported backend", fmt.Sprintf("backend must be %q or %q, got %q", backendGitHub, backendBitbucket, backend))
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *GithubProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewRepositoryResource,
+		NewRepositoryForkResource,
+		NewRepositoryWebhookResource,
+	}
+}
+
+func (p *GithubProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
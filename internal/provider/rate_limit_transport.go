@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimitTransport wraps an http.RoundTripper to keep GitHubClient within
+// GitHub's primary and secondary rate limits: it blocks new requests once
+// the primary limit is exhausted until the window resets, and retries 403
+// "secondary rate limit" / 429 responses with backoff, honoring Retry-After
+// when GitHub provides it.
+type rateLimitTransport struct {
+	next http.RoundTripper
+	maxRetries int
+	minRateLimitRemaining int
+	clock func() time.Time
+
+	// sem bounds the number of requests in flight at once so a bulk
+	// terraform apply over many resources doesn't trip abuse detection.
+	sem chan struct{}
+
+	mu sync.Mutex
+	remaining int
+	reset time.Time
+	haveLimit bool
+}
+
+func newRateLimitTransport(next http.RoundTripper, cfg *clientConfig) *rateLimitTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	maxConcurrent := cfg.maxConcurrentRequests
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+
+	return &rateLimitTransport{
+		next: next,
+		maxRetries: cfg.maxRetries,
+		minRateLimitRemaining: cfg.minRateLimitRemaining,
+		clock: cfg.clock,
+		sem: make(chan struct{}, maxConcurrent),
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.sem }()
+
+	if err := t.waitForCapacity(req); err != nil {
+		return nil, err
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if rewindErr := rewindRequestBody(req); rewindErr != nil {
+				return nil, rewindErr
+			}
+		}
+
+		resp, err = t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		t.recordRateLimit(resp)
+
+		if attempt >= t.maxRetries {
+			return resp, nil
+		}
+
+		wait, retry := t.retryDelay(resp, attempt)
+		if !retry {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// waitForCapacity blocks until the primary rate limit window has reset,
+// if the last observed response left minRateLimitRemaining or fewer
+// requests available.
+func (t *rateLimitTransport) waitForCapacity(req *http.Request) error {
+	t.mu.Lock()
+	wait := time.Duration(0)
+	if t.haveLimit && t.remaining <= t.minRateLimitRemaining {
+		if until := t.reset.Sub(t.clock()); until > 0 {
+			wait = until
+		}
+	}
+	t.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+func (t *rateLimitTransport) recordRateLimit(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	resetUnix, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	t.remaining = remaining
+	t.reset = time.Unix(resetUnix, 0)
+	t.haveLimit = true
+	t.mu.Unlock()
+}
+
+// retryDelay reports whether resp looks like a rate limit response worth
+// retrying, and how long to wait before the next attempt. It honors
+// Retry-After when present and otherwise backs off exponentially with
+// jitter.
+func (t *rateLimitTransport) retryDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return retryAfterOrBackoff(resp, attempt), true
+	}
+
+	if resp.StatusCode != http.StatusForbidden {
+		return 0, false
+	}
+
+	if resp.Header.Get("Retry-After") != "" {
+		return retryAfterOrBackoff(resp, attempt), true
+	}
+
+	if !isSecondaryRateLimitResponse(resp) {
+		return 0, false
+	}
+
+	return retryAfterOrBackoff(resp, attempt), true
+}
+
+// isSecondaryRateLimitResponse peeks at the response body for GitHub's
+// "secondary rate limit" message, then restores the body so downstream
+// decoding still works.
+func isSecondaryRateLimitResponse(resp *http.Response) bool {
+	bodyBytes, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(string(bodyBytes)), "secondary rate limit")
+}
+
+func retryAfterOrBackoff(resp *http.Response, attempt int) time.Duration {
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+
+	backoff := time.Duration(1<<uint(attempt)) * time.Second
+	jitter := time.Duration(rand.Int63n(int64(time.Second)))
+	return backoff + jitter
+}
+
+func rewindRequestBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+
+	req.Body = body
+	return nil
+}
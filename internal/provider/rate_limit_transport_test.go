@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	transport := &rateLimitTransport{}
+
+	t.Run("429 always retries and honors Retry-After", func(t *testing.T) {
+		resp := httptest.NewRecorder().Result()
+		resp.StatusCode = http.StatusTooManyRequests
+		resp.Header.Set("Retry-After", "2")
+
+		wait, retry := transport.retryDelay(resp, 0)
+		if !retry {
+			t.Fatal("expected retry=true for 429")
+		}
+		if wait != 2*time.Second {
+			t.Errorf("wait = %v, want 2s", wait)
+		}
+	})
+
+	t.Run("403 with Retry-After retries", func(t *testing.T) {
+		resp := httptest.NewRecorder().Result()
+		resp.StatusCode = http.StatusForbidden
+		resp.Header.Set("Retry-After", "5")
+
+		wait, retry := transport.retryDelay(resp, 0)
+		if !retry {
+			t.Fatal("expected retry=true for 403 with Retry-After")
+		}
+		if wait != 5*time.Second {
+			t.Errorf("wait = %v, want 5s", wait)
+		}
+	})
+
+	t.Run("403 secondary rate limit body retries", func(t *testing.T) {
+		resp := httptest.NewRecorder().Result()
+		resp.StatusCode = http.StatusForbidden
+		resp.Body = io.NopCloser(strings.NewReader(`{"message":"You have exceeded a secondary rate limit"}`))
+
+		_, retry := transport.retryDelay(resp, 0)
+		if !retry {
+			t.Fatal("expected retry=true for a secondary rate limit body")
+		}
+	})
+
+	t.Run("403 without Retry-After or secondary rate limit body does not retry", func(t *testing.T) {
+		resp := httptest.NewRecorder().Result()
+		resp.StatusCode = http.StatusForbidden
+		resp.Body = io.NopCloser(strings.NewReader(`{"message":"Bad credentials"}`))
+
+		_, retry := transport.retryDelay(resp, 0)
+		if retry {
+			t.Fatal("expected retry=false for an ordinary 403")
+		}
+	})
+
+	t.Run("200 does not retry", func(t *testing.T) {
+		resp := httptest.NewRecorder().Result()
+		resp.StatusCode = http.StatusOK
+
+		_, retry := transport.retryDelay(resp, 0)
+		if retry {
+			t.Fatal("expected retry=false for a 200")
+		}
+	})
+}
+
+func TestRetryAfterOrBackoff(t *testing.T) {
+	t.Run("uses Retry-After when present", func(t *testing.T) {
+		resp := httptest.NewRecorder().Result()
+		resp.Header.Set("Retry-After", "3")
+
+		if got := retryAfterOrBackoff(resp, 5); got != 3*time.Second {
+			t.Errorf("got %v, want 3s", got)
+		}
+	})
+
+	t.Run("falls back to exponential backoff with jitter under a second", func(t *testing.T) {
+		resp := httptest.NewRecorder().Result()
+
+		got := retryAfterOrBackoff(resp, 2)
+		min := 4 * time.Second
+		max := 5 * time.Second
+		if got < min || got >= max {
+			t.Errorf("got %v, want in [%v, %v)", got, min, max)
+		}
+	})
+}
+
+func TestWaitForCapacity(t *testing.T) {
+	t.Run("no wait when limit has not been observed", func(t *testing.T) {
+		transport := &rateLimitTransport{clock: time.Now}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		start := time.Now()
+		if err := transport.waitForCapacity(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+			t.Errorf("expected no wait, took %v", elapsed)
+		}
+	})
+
+	t.Run("no wait when remaining is above the threshold", func(t *testing.T) {
+		now := time.Now()
+		transport := &rateLimitTransport{
+			clock:                 func() time.Time { return now },
+			minRateLimitRemaining: 1,
+			haveLimit:             true,
+			remaining:             10,
+			reset:                 now.Add(time.Hour),
+		}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		if err := transport.waitForCapacity(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("blocks until the reset time when remaining is exhausted", func(t *testing.T) {
+		now := time.Now()
+		transport := &rateLimitTransport{
+			clock:                 func() time.Time { return now },
+			minRateLimitRemaining: 1,
+			haveLimit:             true,
+			remaining:             0,
+			reset:                 now.Add(75 * time.Millisecond),
+		}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		start := time.Now()
+		if err := transport.waitForCapacity(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+			t.Errorf("expected to block roughly until reset, only waited %v", elapsed)
+		}
+	})
+
+	t.Run("returns the context error if canceled while waiting", func(t *testing.T) {
+		now := time.Now()
+		transport := &rateLimitTransport{
+			clock:                 func() time.Time { return now },
+			minRateLimitRemaining: 1,
+			haveLimit:             true,
+			remaining:             0,
+			reset:                 now.Add(time.Hour),
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+
+		err := transport.waitForCapacity(req)
+		if err == nil {
+			t.Fatal("expected a context deadline error")
+		}
+	})
+}
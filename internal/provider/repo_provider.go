@@ -0,0 +1,44 @@
+package provider
+
+import "context"
+
+// RepoProvider is the set of repository operations RepositoryResource
+// needs, implemented by GitHubClient (github.com and GHES) and
+// BitbucketClient, so the resource works the same way regardless of
+// which backend a user configures.
+type RepoProvider interface {
+	CreateRepo(ctx context.Context, req *CreateRepositoryRequest) (*Repository, error)
+	GetRepo(ctx context.Context, owner, name string) (*Repository, error)
+	UpdateRepo(ctx context.Context, owner, name string, req *UpdateRepositoryRequest) (*Repository, error)
+	DeleteRepo(ctx context.Context, owner, name string) error
+}
+
+// TemplateRepoProvider is implemented by backends that can generate a new
+// repository from an existing template repository. Only GitHubClient
+// supports this today; Bitbucket has no equivalent endpoint.
+type TemplateRepoProvider interface {
+	GenerateRepoFromTemplate(ctx context.Context, templateOwner, templateRepo string, req *GenerateRepositoryRequest) (*Repository, error)
+}
+
+// TopicsRepoProvider is implemented by backends that support setting
+// repository topics. Bitbucket has no equivalent concept.
+type TopicsRepoProvider interface {
+	SetTopics(ctx context.Context, owner, name string, topics []string) ([]string, error)
+}
+
+// OwnerScopedRepoProvider is implemented by backends whose create endpoint
+// is scoped by an explicit owner/workspace (Bitbucket) rather than implied
+// by the authenticated account (GitHub, which ignores
+// CreateRepositoryRequest.Owner entirely). RepositoryResource uses this to
+// reject a practitioner-supplied "owner" up front instead of silently
+// dropping it and leaving a permanent plan diff.
+type OwnerScopedRepoProvider interface {
+	RepoProvider
+	scopedByOwner()
+}
+
+var _ RepoProvider = &GitHubClient{}
+var _ TemplateRepoProvider = &GitHubClient{}
+var _ TopicsRepoProvider = &GitHubClient{}
+var _ RepoProvider = &BitbucketClient{}
+var _ OwnerScopedRepoProvider = &BitbucketClient{}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,10 +21,11 @@ import (
 
 var _ resource.Resource = &RepositoryResource{}
 var _ resource.ResourceWithImportState = &RepositoryResource{}
+var _ resource.ResourceWithValidateConfig = &RepositoryResource{}
 
 
 type RepositoryResource struct {
-	client *GitHubClient
+	client RepoProvider
 }
 
 
@@ -36,6 +40,26 @@ type RepositoryResourceModel struct {
 	AutoInit types.Bool `tfsdk:"auto_init"`
 	FullName types.String `tfsdk:"full_name"`
 	Owner types.String `tfsdk:"owner"`
+	IsTemplate types.Bool `tfsdk:"is_template"`
+	Template *RepositoryTemplateModel `tfsdk:"template"`
+	HomepageURL types.String `tfsdk:"homepage_url"`
+	HasDownloads types.Bool `tfsdk:"has_downloads"`
+	DefaultBranch types.String `tfsdk:"default_branch"`
+	SSHCloneURL types.String `tfsdk:"ssh_clone_url"`
+	GitCloneURL types.String `tfsdk:"git_clone_url"`
+	HTTPCloneURL types.String `tfsdk:"http_clone_url"`
+	SvnURL types.String `tfsdk:"svn_url"`
+	Topics types.Set `tfsdk:"topics"`
+	LicenseTemplate types.String `tfsdk:"license_template"`
+	GitignoreTemplate types.String `tfsdk:"gitignore_template"`
+}
+
+// data model for the "template" nested attribute, describing the
+// existing template repository to generate a new repository from
+type RepositoryTemplateModel struct {
+	Owner types.String `tfsdk:"owner"`
+	Repository types.String `tfsdk:"repository"`
+	IncludeAllBranches types.Bool `tfsdk:"include_all_branches"`
 }
 
 func NewRepositoryResource() resource.Resource {
@@ -99,9 +123,93 @@ func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 			},
 			"owner": schema.StringAttribute{
-				MarkdownDescription: "Repository owner",
+				MarkdownDescription: "Repository owner. On the Bitbucket backend this is the target workspace and must be set, since Bitbucket's create endpoint is scoped by owner in the URL; on GitHub/GHES it is always computed from the authenticated user and setting it is an error.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_template": schema.BoolAttribute{
+				MarkdownDescription: "Whether the repository is marked as a template that others can generate new repositories from",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"template": schema.SingleNestedAttribute{
+				MarkdownDescription: "Generate this repository from an existing template repository instead of creating it empty",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"owner": schema.StringAttribute{
+						MarkdownDescription: "Owner of the template repository",
+						Required:            true,
+					},
+					"repository": schema.StringAttribute{
+						MarkdownDescription: "Name of the template repository",
+						Required:            true,
+					},
+					"include_all_branches": schema.BoolAttribute{
+						MarkdownDescription: "Whether to copy all branches from the template, not just the default branch",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"homepage_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository's homepage",
+				Optional:            true,
+			},
+			"has_downloads": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable downloads for the repository",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"default_branch": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository's default branch",
+				Computed:            true,
+			},
+			"ssh_clone_url": schema.StringAttribute{
+				MarkdownDescription: "SSH URL to clone the repository",
+				Computed:            true,
+			},
+			"git_clone_url": schema.StringAttribute{
+				MarkdownDescription: "git:// URL to clone the repository",
 				Computed:            true,
 			},
+			"http_clone_url": schema.StringAttribute{
+				MarkdownDescription: "HTTPS URL to clone the repository",
+				Computed:            true,
+			},
+			"svn_url": schema.StringAttribute{
+				MarkdownDescription: "Subversion URL to check out the repository",
+				Computed:            true,
+			},
+			"topics": schema.SetAttribute{
+				MarkdownDescription: "Topics applied to the repository",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"license_template": schema.StringAttribute{
+				MarkdownDescription: "License template to seed the repository with, e.g. `mit` or `apache-2.0`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gitignore_template": schema.StringAttribute{
+				MarkdownDescription: "gitignore template to seed the repository with, e.g. `Go`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -112,11 +220,11 @@ func (r *RepositoryResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*GitHubClient)
+	client, ok := req.ProviderData.(RepoProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *GitHubClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected a RepoProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -124,6 +232,59 @@ func (r *RepositoryResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+// ValidateConfig warns when a practitioner sets an attribute the configured
+// backend doesn't support, e.g. auto_init on Bitbucket: BitbucketClient's
+// create/update requests (bitbucket_client.go) only map
+// description/private/has_issues/has_wiki and silently drop everything
+// else, so without this the attribute is accepted and then has no effect.
+// template and topics are not dropped: Create/Update fail on them.
+// This can only run once the backend has been configured (r.client is nil
+// during "terraform validate", which doesn't configure the provider), so
+// it's a best-effort warning rather than a hard guarantee.
+func (r *RepositoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r.client == nil {
+		return
+	}
+	if _, ok := r.client.(*BitbucketClient); !ok {
+		return
+	}
+
+	var data RepositoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	const summary = "Unsupported attribute for this backend"
+	const detail = "this attribute is not supported by the Bitbucket backend and will be ignored"
+	const failDetail = "this attribute is not supported by the Bitbucket backend and applying it will fail"
+
+	if data.AutoInit.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("auto_init"), summary, detail)
+	}
+	if data.IsTemplate.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("is_template"), summary, detail)
+	}
+	if data.Template != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("template"), summary, failDetail)
+	}
+	if !data.Topics.IsNull() && len(data.Topics.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("topics"), summary, failDetail)
+	}
+	if data.LicenseTemplate.ValueString() != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("license_template"), summary, detail)
+	}
+	if data.GitignoreTemplate.ValueString() != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("gitignore_template"), summary, detail)
+	}
+	if data.HomepageURL.ValueString() != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("homepage_url"), summary, detail)
+	}
+	if data.HasDownloads.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("has_downloads"), summary, detail)
+	}
+}
+
 func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RepositoryResourceModel
 
@@ -132,19 +293,81 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	createRequest := &CreateRepositoryRequest{
-		Name: data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		Private: data.Private.ValueBool(),
-		HasIssues: data.HasIssues.ValueBool(),
-		HasWiki: data.HasWiki.ValueBool(),
-		AutoInit: data.AutoInit.ValueBool(),
+	if _, ok := r.client.(OwnerScopedRepoProvider); !ok && !data.Owner.IsNull() && !data.Owner.IsUnknown() && data.Owner.ValueString() != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("owner"),
+			"Unsupported attribute for this backend",
+			"owner only applies to backends whose create endpoint is scoped by an explicit owner/workspace (Bitbucket). The configured backend always creates under the authenticated account, so a configured owner would never converge; remove it from this resource's configuration.",
+		)
+		return
 	}
 
-	repository, err := r.client.CreateRepo(ctx, createRequest)
+	var repository *Repository
+	var err error
+
+	if data.Template != nil {
+		templateProvider, ok := r.client.(TemplateRepoProvider)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unsupported operation",
+				"The configured backend does not support generating repositories from a template",
+			)
+			return
+		}
+
+		generateRequest := &GenerateRepositoryRequest{
+			Name: data.Name.ValueString(),
+			Description: data.Description.ValueString(),
+			Private: data.Private.ValueBool(),
+			IncludeAllBranches: data.Template.IncludeAllBranches.ValueBool(),
+		}
+
+		repository, err = templateProvider.GenerateRepoFromTemplate(ctx, data.Template.Owner.ValueString(), data.Template.Repository.ValueString(), generateRequest)
+		if err != nil {
+			addAPIErrorDiagnostics(&resp.Diagnostics, "Could not generate repo from template", err)
+			return
+		}
+	} else {
+		createRequest := &CreateRepositoryRequest{
+			Owner: data.Owner.ValueString(),
+			Name: data.Name.ValueString(),
+			Description: data.Description.ValueString(),
+			Private: data.Private.ValueBool(),
+			HasIssues: data.HasIssues.ValueBool(),
+			HasWiki: data.HasWiki.ValueBool(),
+			AutoInit: data.AutoInit.ValueBool(),
+			Homepage: data.HomepageURL.ValueString(),
+			HasDownloads: data.HasDownloads.ValueBool(),
+			LicenseTemplate: data.LicenseTemplate.ValueString(),
+			GitignoreTemplate: data.GitignoreTemplate.ValueString(),
+		}
+
+		repository, err = r.client.CreateRepo(ctx, createRequest)
+		if err != nil {
+			addAPIErrorDiagnostics(&resp.Diagnostics, "Could not create repo", err)
+			return
+		}
+	}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client error", fmt.Sprintf("Could not create repo, error: %s", err))
+	// is_template can only be set via PATCH, neither the regular create nor
+	// the generate-from-template endpoint accepts it at creation time.
+	if data.IsTemplate.ValueBool() {
+		repository, err = r.client.UpdateRepo(ctx, repository.Owner.Login, repository.Name, &UpdateRepositoryRequest{
+			Description: repository.Description,
+			Private: repository.Private,
+			HasIssues: repository.HasIssues,
+			HasWiki: repository.HasWiki,
+			IsTemplate: true,
+			HasDownloads: repository.HasDownloads,
+		})
+		if err != nil {
+			addAPIErrorDiagnostics(&resp.Diagnostics, "Could not mark repo as a template", err)
+			return
+		}
+	}
+
+	repository = setRepositoryTopics(ctx, &data, repository, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -156,6 +379,15 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	data.HasWiki = types.BoolValue(repository.HasWiki)
 	data.FullName = types.StringValue(repository.FullName)
 	data.Owner = types.StringValue(repository.Owner.Login)
+	data.IsTemplate = types.BoolValue(repository.IsTemplate)
+	applyRepositoryComputedFields(&data, repository)
+
+	topicsSet, topicsDiags := types.SetValueFrom(ctx, types.StringType, repository.Topics)
+	resp.Diagnostics.Append(topicsDiags...)
+	data.Topics = topicsSet
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -172,15 +404,27 @@ func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Update repository via GitHub API
 	updateReq := &UpdateRepositoryRequest{
-		Description: data.Description.ValueString(),
-		Private:     data.Private.ValueBool(),
-		HasIssues:   data.HasIssues.ValueBool(),
-		HasWiki:     data.HasWiki.ValueBool(),
+		Description:  data.Description.ValueString(),
+		Private:      data.Private.ValueBool(),
+		HasIssues:    data.HasIssues.ValueBool(),
+		HasWiki:      data.HasWiki.ValueBool(),
+		IsTemplate:   data.IsTemplate.ValueBool(),
+		Homepage:     data.HomepageURL.ValueString(),
+		HasDownloads: data.HasDownloads.ValueBool(),
 	}
 
 	repository, err := r.client.UpdateRepo(ctx, data.Owner.ValueString(), data.Name.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository, got error: %s", err))
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to update repository", err)
+		return
+	}
+
+	repository = setRepositoryTopics(ctx, &data, repository, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -189,6 +433,15 @@ func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 	data.Private = types.BoolValue(repository.Private)
 	data.HasIssues = types.BoolValue(repository.HasIssues)
 	data.HasWiki = types.BoolValue(repository.HasWiki)
+	data.IsTemplate = types.BoolValue(repository.IsTemplate)
+	applyRepositoryComputedFields(&data, repository)
+
+	topicsSet, topicsDiags := types.SetValueFrom(ctx, types.StringType, repository.Topics)
+	resp.Diagnostics.Append(topicsDiags...)
+	data.Topics = topicsSet
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -206,8 +459,8 @@ func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	// Delete repository via GitHub API
 	err := r.client.DeleteRepo(ctx, data.Owner.ValueString(), data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository, got error: %s", err))
+	if err != nil && !isNotFoundError(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to delete repository", err)
 		return
 	}
 }
@@ -224,23 +477,97 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Get repository from GitHub API
 	repository, err := r.client.GetRepo(ctx, data.Owner.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository, got error: %s", err))
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to read repository", err)
 		return
 	}
 
 	// Update model with latest data
+	data.ID = types.StringValue(strconv.FormatInt(repository.ID, 10))
 	data.Description = types.StringValue(repository.Description)
 	data.Private = types.BoolValue(repository.Private)
 	data.HasIssues = types.BoolValue(repository.HasIssues)
 	data.HasWiki = types.BoolValue(repository.HasWiki)
 	data.FullName = types.StringValue(repository.FullName)
+	data.IsTemplate = types.BoolValue(repository.IsTemplate)
+	applyRepositoryComputedFields(&data, repository)
+
+	topicsSet, topicsDiags := types.SetValueFrom(ctx, types.StringType, repository.Topics)
+	resp.Diagnostics.Append(topicsDiags...)
+	data.Topics = topicsSet
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// applyRepositoryComputedFields copies the backend-reported homepage,
+// downloads flag, default branch and clone URLs from repo into data.
+func applyRepositoryComputedFields(data *RepositoryResourceModel, repo *Repository) {
+	// homepage_url is Optional but not Computed, so an unset value stays
+	// null instead of becoming "" when the backend reports no homepage.
+	if repo.Homepage != "" || !data.HomepageURL.IsNull() {
+		data.HomepageURL = types.StringValue(repo.Homepage)
+	}
+	data.HasDownloads = types.BoolValue(repo.HasDownloads)
+	data.DefaultBranch = types.StringValue(repo.DefaultBranch)
+	data.SSHCloneURL = types.StringValue(repo.SSHCloneURL)
+	data.GitCloneURL = types.StringValue(repo.GitCloneURL)
+	data.HTTPCloneURL = types.StringValue(repo.HTTPCloneURL)
+	data.SvnURL = types.StringValue(repo.SvnURL)
+}
+
+// setRepositoryTopics pushes the practitioner-configured topics to the
+// backend, if any were configured, and returns the repository with its
+// Topics field updated to match. Backends that don't implement
+// TopicsRepoProvider produce a diagnostic error instead of silently
+// ignoring the configured topics.
+func setRepositoryTopics(ctx context.Context, data *RepositoryResourceModel, repository *Repository, client RepoProvider, diags *diag.Diagnostics) *Repository {
+	if data.Topics.IsNull() || data.Topics.IsUnknown() {
+		return repository
+	}
+
+	var topics []string
+	diags.Append(data.Topics.ElementsAs(ctx, &topics, false)...)
+	if diags.HasError() {
+		return repository
+	}
+
+	topicsProvider, ok := client.(TopicsRepoProvider)
+	if !ok {
+		diags.AddError("Unsupported operation", "The configured backend does not support repository topics")
+		return repository
+	}
+
+	updatedTopics, err := topicsProvider.SetTopics(ctx, repository.Owner.Login, repository.Name, topics)
+	if err != nil {
+		addAPIErrorDiagnostics(diags, "Could not set repository topics", err)
+		return repository
+	}
+
+	repository.Topics = updatedTopics
+	return repository
+}
+
+// ImportState accepts an import ID of the form "owner/repository_name",
+// hydrating owner, name and full_name so the framework's automatic
+// post-import Read has enough state to call GetRepo.
 func (r *RepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: owner/repository_name
-	// For now, we'll use the ID field to store this
+	owner, name, found := strings.Cut(req.ID, "/")
+	if !found || owner == "" || name == "" || strings.Contains(name, "/") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier of the form \"owner/repository_name\", got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), owner)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("full_name"), req.ID)...)
 }
\ No newline at end of file
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// provider defined types satisfy framework
+
+var _ resource.Resource = &RepositoryForkResource{}
+
+type RepositoryForkResource struct {
+	client *GitHubClient
+}
+
+// data model for repo fork resource
+type RepositoryForkResourceModel struct {
+	ID	types.String `tfsdk:"id"`
+	Owner types.String `tfsdk:"owner"`
+	Repository types.String `tfsdk:"repository"`
+	Organization types.String `tfsdk:"organization"`
+	Name types.String `tfsdk:"name"`
+	DefaultBranchOnly types.Bool `tfsdk:"default_branch_only"`
+	FullName types.String `tfsdk:"full_name"`
+}
+
+func NewRepositoryForkResource() resource.Resource {
+	return &RepositoryForkResource{}
+}
+
+func (r *RepositoryForkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_fork"
+}
+
+func (r *RepositoryForkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "GitHub repository fork resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Forked repository ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Owner of the repository to fork",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository to fork",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Organization to fork the repository into, defaults to the current user",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name to give the new fork, defaults to the source repository name",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_branch_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fork only the default branch of the source repository",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the forked repository (owner/name)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *RepositoryForkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GitHubClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitHubClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryForkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryForkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forkRequest := &ForkRepositoryRequest{
+		Organization: data.Organization.ValueString(),
+		Name: data.Name.ValueString(),
+		DefaultBranchOnly: data.DefaultBranchOnly.ValueBool(),
+	}
+
+	repository, err := r.client.ForkRepo(ctx, data.Owner.ValueString(), data.Repository.ValueString(), forkRequest)
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Could not fork repo", err)
+		return
+	}
+
+	// Forking is asynchronous on GitHub's side, so wait for the fork to
+	// actually become readable before writing it to state.
+	repository, err = r.client.WaitForForkReady(ctx, repository.Owner.Login, repository.Name)
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Fork did not become ready", err)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(repository.ID, 10))
+	data.Name = types.StringValue(repository.Name)
+	data.FullName = types.StringValue(repository.FullName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryForkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryForkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The fork lives under the account or organization that made it, not
+	// under the source repository's owner; full_name records where.
+	owner, _, _ := strings.Cut(data.FullName.ValueString(), "/")
+	if owner == "" {
+		owner = data.Organization.ValueString()
+	}
+
+	repository, err := r.client.GetRepo(ctx, owner, data.Name.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to read forked repository", err)
+		return
+	}
+
+	data.Name = types.StringValue(repository.Name)
+	data.FullName = types.StringValue(repository.FullName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is a no-op: every attribute on this resource forces replacement.
+func (r *RepositoryForkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *RepositoryForkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryForkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The fork lives under the account or organization that made it, not
+	// under the source repository's owner; full_name records where.
+	owner, _, _ := strings.Cut(data.FullName.ValueString(), "/")
+	if owner == "" {
+		owner = data.Organization.ValueString()
+	}
+
+	err := r.client.DeleteRepo(ctx, owner, data.Name.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to delete forked repository", err)
+		return
+	}
+}
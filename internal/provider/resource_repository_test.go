@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccRepositoryResource_import exercises ImportState's owner/name
+// parsing end to end: the import step leaves "owner" and "name" in state
+// before the framework's automatic Read runs, so ImportStateVerify catches
+// any regression where those attributes are left unset.
+func TestAccRepositoryResource_import(t *testing.T) {
+	rName := "tf-acc-test-import"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryResourceConfig(rName),
+			},
+			{
+				ResourceName:            "github_repository.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_init"},
+			},
+		},
+	})
+}
+
+func testAccRepositoryResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = %q
+}
+`, name)
+}
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// provider defined types satisfy framework
+
+var _ resource.Resource = &RepositoryWebhookResource{}
+
+type RepositoryWebhookResource struct {
+	client *GitHubClient
+}
+
+// data model for repo webhook resource
+type RepositoryWebhookResourceModel struct {
+	ID	types.String `tfsdk:"id"`
+	Owner types.String `tfsdk:"owner"`
+	Repository types.String `tfsdk:"repository"`
+	Events []types.String `tfsdk:"events"`
+	Active types.Bool `tfsdk:"active"`
+	Config *RepositoryWebhookConfigModel `tfsdk:"config"`
+}
+
+// data model for the "config" nested attribute
+type RepositoryWebhookConfigModel struct {
+	URL types.String `tfsdk:"url"`
+	ContentType types.String `tfsdk:"content_type"`
+	InsecureSSL types.String `tfsdk:"insecure_ssl"`
+	Secret types.String `tfsdk:"secret"`
+	SecretHash types.String `tfsdk:"secret_hash"`
+}
+
+func NewRepositoryWebhookResource() resource.Resource {
+	return &RepositoryWebhookResource{}
+}
+
+func (r *RepositoryWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_webhook"
+}
+
+func (r *RepositoryWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "GitHub repository webhook resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Webhook ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Owner of the repository",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"events": schema.ListAttribute{
+				MarkdownDescription: "Events that trigger the webhook",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webhook is active",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configuration for the webhook payload delivery",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL the payload is delivered to",
+						Required:            true,
+					},
+					"content_type": schema.StringAttribute{
+						MarkdownDescription: "Media type used to serialize the payload, `json` or `form`",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("json"),
+					},
+					"insecure_ssl": schema.StringAttribute{
+						MarkdownDescription: "Whether SSL verification is performed when delivering payloads, `0` or `1`",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("0"),
+					},
+					"secret": schema.StringAttribute{
+						MarkdownDescription: "Secret used to sign payload deliveries via HMAC hex digest",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"secret_hash": schema.StringAttribute{
+						MarkdownDescription: "SHA-256 hash of the configured secret, used to detect drift since GitHub never returns the secret itself",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RepositoryWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GitHubClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GitHubClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func hashWebhookSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func eventsToStrings(events []types.String) []string {
+	out := make([]string, len(events))
+	for i, e := range events {
+		out[i] = e.ValueString()
+	}
+	return out
+}
+
+func stringsToEvents(events []string) []types.String {
+	out := make([]types.String, len(events))
+	for i, e := range events {
+		out[i] = types.StringValue(e)
+	}
+	return out
+}
+
+func (r *RepositoryWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryWebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hookRequest := &HookRequest{
+		Name: "web",
+		Active: data.Active.ValueBool(),
+		Events: eventsToStrings(data.Events),
+		Config: HookConfig{
+			URL: data.Config.URL.ValueString(),
+			ContentType: data.Config.ContentType.ValueString(),
+			InsecureSSL: data.Config.InsecureSSL.ValueString(),
+			Secret: data.Config.Secret.ValueString(),
+		},
+	}
+
+	hook, err := r.client.CreateHook(ctx, data.Owner.ValueString(), data.Repository.ValueString(), hookRequest)
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Could not create webhook", err)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(hook.ID, 10))
+	data.Active = types.BoolValue(hook.Active)
+	data.Events = stringsToEvents(hook.Events)
+	data.Config.URL = types.StringValue(hook.Config.URL)
+	data.Config.ContentType = types.StringValue(hook.Config.ContentType)
+	data.Config.InsecureSSL = types.StringValue(hook.Config.InsecureSSL)
+	data.Config.SecretHash = types.StringValue(hashWebhookSecret(data.Config.Secret.ValueString()))
+	// GitHub never returns the secret, and it's only kept in state as a
+	// hash for drift detection, so don't persist the plaintext value
+	// itself: Sensitive only redacts CLI/plan output, not the state file.
+	data.Config.Secret = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryWebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse webhook ID, got error: %s", err))
+		return
+	}
+
+	hook, err := r.client.GetHook(ctx, data.Owner.ValueString(), data.Repository.ValueString(), id)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to read webhook", err)
+		return
+	}
+
+	data.Active = types.BoolValue(hook.Active)
+	data.Events = stringsToEvents(hook.Events)
+	data.Config.URL = types.StringValue(hook.Config.URL)
+	data.Config.ContentType = types.StringValue(hook.Config.ContentType)
+	data.Config.InsecureSSL = types.StringValue(hook.Config.InsecureSSL)
+	// GitHub never returns the secret, so the locally-held secret and its
+	// hash are left untouched here rather than compared against the API.
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RepositoryWebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse webhook ID, got error: %s", err))
+		return
+	}
+
+	hookRequest := &HookRequest{
+		Name: "web",
+		Active: data.Active.ValueBool(),
+		Events: eventsToStrings(data.Events),
+		Config: HookConfig{
+			URL: data.Config.URL.ValueString(),
+			ContentType: data.Config.ContentType.ValueString(),
+			InsecureSSL: data.Config.InsecureSSL.ValueString(),
+			Secret: data.Config.Secret.ValueString(),
+		},
+	}
+
+	hook, err := r.client.UpdateHook(ctx, data.Owner.ValueString(), data.Repository.ValueString(), id, hookRequest)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to update webhook", err)
+		return
+	}
+
+	data.Active = types.BoolValue(hook.Active)
+	data.Events = stringsToEvents(hook.Events)
+	data.Config.URL = types.StringValue(hook.Config.URL)
+	data.Config.ContentType = types.StringValue(hook.Config.ContentType)
+	data.Config.InsecureSSL = types.StringValue(hook.Config.InsecureSSL)
+	data.Config.SecretHash = types.StringValue(hashWebhookSecret(data.Config.Secret.ValueString()))
+	// GitHub never returns the secret, and it's only kept in state as a
+	// hash for drift detection, so don't persist the plaintext value
+	// itself: Sensitive only redacts CLI/plan output, not the state file.
+	data.Config.Secret = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryWebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse webhook ID, got error: %s", err))
+		return
+	}
+
+	err = r.client.DeleteHook(ctx, data.Owner.ValueString(), data.Repository.ValueString(), id)
+	if err != nil && !isNotFoundError(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Unable to delete webhook", err)
+		return
+	}
+}